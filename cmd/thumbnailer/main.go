@@ -37,6 +37,8 @@ var Scalers = map[string]draw.Scaler{
 	"ApproxBiLinear":  draw.ApproxBiLinear,
 	"BiLinear":        draw.BiLinear,
 	"CatmullRom":      draw.CatmullRom,
+	"Mitchell":        thumbnailer.Mitchell,
+	"Lanczos3":        thumbnailer.Lanczos3,
 }
 
 var OutFormats = map[string]thumbnailer.OutputFormat{
@@ -188,7 +190,7 @@ func main() {
 	rootCmd.Flags().IntVarP(&c.Quality, "jpg-quality", "j", jpeg.DefaultQuality,
 		"quality for JPG output (0-100)")
 	rootCmd.Flags().StringVarP(&c.Scaler, "scaler", "s", "ApproxBiLinear",
-		"scaler to use when downsizing images (NearestNeighbor/ApproxBiLinear/BiLinear/CatmullRom)")
+		"scaler to use when downsizing images (NearestNeighbor/ApproxBiLinear/BiLinear/CatmullRom/Mitchell/Lanczos3)")
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "%v", err)
@@ -67,7 +67,7 @@ func OutFormat(value OutputFormat) Option {
 }
 
 // Scaler sets the [draw.Scaler] used by Create.
-// By default, the [draw.ApproxBiLinear] scaler is used.
+// By default, the [draw.ApproxBiLinear] scaler is used. See also [Mitchell] and [Lanczos3].
 func Scaler(value draw.Scaler) Option {
 	return func(t *Thumbnailer) {
 		t.scaler = value
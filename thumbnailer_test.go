@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/draw"
 )
 
 func loadTestImage(t *testing.T, name string) []byte {
@@ -107,3 +108,34 @@ func TestThumbnailer_BadData(t *testing.T) {
 	_, err := New(Image([]byte("this is not an image!"))).Create()
 	assert.Error(t, err)
 }
+
+func TestThumbnailer_Scaler(t *testing.T) {
+	t.Parallel()
+
+	testImage := loadTestImage(t, "soccerball.png")
+
+	const maxSize = 100
+
+	for _, scaler := range []draw.Scaler{Lanczos3, Mitchell} {
+		thumbnailData, err := New(Image(testImage), MaxSize(maxSize), Scaler(scaler)).Create()
+		assert.NoError(t, err)
+
+		thumbnail, _ := decode(t, thumbnailData)
+		thumbnailWidth, thumbnailHeight := dimensions(thumbnail)
+
+		assert.LessOrEqual(t, thumbnailWidth, maxSize)
+		assert.LessOrEqual(t, thumbnailHeight, maxSize)
+	}
+}
+
+func TestKernels(t *testing.T) {
+	t.Parallel()
+
+	for _, kernel := range []*draw.Kernel{Lanczos3, Mitchell} {
+		assert.InDelta(t, 0, kernel.At(kernel.Support), 1e-9)
+		assert.Equal(t, kernel.At(0.5), kernel.At(-0.5))
+	}
+	assert.Equal(t, 1.0, Lanczos3.At(0))
+	assert.InDelta(t, 0, Lanczos3.At(1), 1e-9)
+	assert.InDelta(t, 8.0/9, Mitchell.At(0), 1e-9)
+}